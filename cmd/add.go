@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cey0/NextGo/internal/scaffold"
+	"github.com/spf13/cobra"
+)
+
+var addModelFields string
+
+var addCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Add a component to an existing project",
+}
+
+var addHandlerCmd = &cobra.Command{
+	Use:   "handler <Name>",
+	Short: "Add a handler and register its route with the router",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		root, err := projectRoot()
+		if err != nil {
+			return err
+		}
+		return scaffold.AddHandler(root, args[0], writerFromFlags())
+	},
+}
+
+var addModelCmd = &cobra.Command{
+	Use:   "model <Name>",
+	Short: "Add a model struct",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		root, err := projectRoot()
+		if err != nil {
+			return err
+		}
+		return scaffold.AddModel(root, args[0], addModelFields, writerFromFlags())
+	},
+}
+
+var addMiddlewareCmd = &cobra.Command{
+	Use:   "middleware <Name>",
+	Short: "Add a middleware",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		root, err := projectRoot()
+		if err != nil {
+			return err
+		}
+		return scaffold.AddMiddleware(root, args[0], writerFromFlags())
+	},
+}
+
+var addMigrationCmd = &cobra.Command{
+	Use:   "migration <Name>",
+	Short: "Add a SQL migration",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		root, err := projectRoot()
+		if err != nil {
+			return err
+		}
+		return scaffold.AddMigration(root, args[0], writerFromFlags())
+	},
+}
+
+// projectRoot resolves the nextgo project containing the current directory.
+func projectRoot() (string, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return "", fmt.Errorf("getting working directory: %w", err)
+	}
+	return scaffold.FindProjectRoot(cwd)
+}
+
+func init() {
+	addModelCmd.Flags().StringVar(&addModelFields, "fields", "", "comma-separated name:type pairs, e.g. id:int,name:string")
+
+	addCmd.AddCommand(addHandlerCmd)
+	addCmd.AddCommand(addModelCmd)
+	addCmd.AddCommand(addMiddlewareCmd)
+	addCmd.AddCommand(addMigrationCmd)
+}
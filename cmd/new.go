@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/cey0/NextGo/internal/scaffold"
+	"github.com/spf13/cobra"
+)
+
+var (
+	newPath        string
+	newModule      string
+	newPreset      string
+	newPort        int
+	newWithDocker  bool
+	newWithAir     bool
+	newTemplateDir string
+	newTimezone    string
+	newVolumes     []string
+	newNoGit       bool
+	newGitRemote   string
+	newInstallHook bool
+)
+
+var newCmd = &cobra.Command{
+	Use:   "new <name>",
+	Short: "Scaffold a new project",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		projectName := args[0]
+
+		path, err := scaffold.ExpandTilde(newPath)
+		if err != nil {
+			return fmt.Errorf("expanding --path: %w", err)
+		}
+
+		modulePath := newModule
+		if modulePath == "" {
+			modulePath = projectName
+		}
+
+		cfg := scaffold.Config{
+			ProjectName:  projectName,
+			Path:         path,
+			ModulePath:   modulePath,
+			Preset:       newPreset,
+			Port:         newPort,
+			WithDocker:   newWithDocker,
+			WithAir:      newWithAir,
+			OverlayDir:   scaffold.OverlayDirFromEnv(newTemplateDir),
+			Timezone:     newTimezone,
+			Volumes:      newVolumes,
+			Force:        flagForce,
+			DryRun:       flagDryRun,
+			Diff:         flagDiff,
+			NoGit:        newNoGit,
+			GitRemote:    newGitRemote,
+			InstallHooks: newInstallHook,
+		}
+
+		return scaffold.Generate(cfg)
+	},
+}
+
+func init() {
+	newCmd.Flags().StringVar(&newPath, "path", ".", "parent directory; the project is created in <path>/<name>")
+	newCmd.Flags().StringVar(&newModule, "module", "", "Go module path (defaults to the project name)")
+	newCmd.Flags().StringVar(&newPreset, "preset", scaffold.DefaultPreset, "template bundle to scaffold (gin-postgres, chi-sqlite, echo-mongo, net-http-minimal)")
+	newCmd.Flags().IntVar(&newPort, "port", 8080, "port the generated app listens on")
+	newCmd.Flags().BoolVar(&newWithDocker, "with-docker", false, "generate a Dockerfile and docker-compose.yaml")
+	newCmd.Flags().BoolVar(&newWithAir, "with-air", false, "generate .air.toml and require air for hot reload")
+	newCmd.Flags().StringVar(&newTemplateDir, "template-dir", "", "directory of template overrides, checked before the embedded defaults (env NEXTGO_TEMPLATES)")
+	newCmd.Flags().StringVar(&newTimezone, "timezone", "", "TZ value baked into the generated container (requires --with-docker)")
+	newCmd.Flags().StringArrayVar(&newVolumes, "volume", nil, "host:container volume mount to add to docker-compose.yaml (repeatable, requires --with-docker)")
+	newCmd.Flags().BoolVar(&newNoGit, "no-git", false, "skip git init and the initial commit")
+	newCmd.Flags().StringVar(&newGitRemote, "git-remote", "", "remote URL to add as origin")
+	newCmd.Flags().BoolVar(&newInstallHook, "install-hooks", false, "install a pre-commit hook running gofmt/vet/build")
+}
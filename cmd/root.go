@@ -0,0 +1,48 @@
+// Package cmd implements the nextgo command line surface.
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cey0/NextGo/internal/scaffold"
+	"github.com/spf13/cobra"
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "nextgo",
+	Short: "nextgo scaffolds Go backend projects from opinionated templates",
+	Long: `nextgo generates Go backend projects (router, handlers, models, db
+wiring, Docker and air setup) from a set of preset stacks, and can keep
+adding components to a project after it's been scaffolded.`,
+}
+
+var (
+	flagForce  bool
+	flagDryRun bool
+	flagDiff   bool
+)
+
+// Execute runs the root command, exiting the process on error.
+func Execute() {
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&flagForce, "force", false, "overwrite existing files that differ from what would be generated")
+	rootCmd.PersistentFlags().BoolVar(&flagDryRun, "dry-run", false, "print what would be written without touching the filesystem")
+	rootCmd.PersistentFlags().BoolVar(&flagDiff, "diff", false, "print a unified diff for any file that would be overwritten or skipped")
+
+	rootCmd.AddCommand(newCmd)
+	rootCmd.AddCommand(addCmd)
+	rootCmd.AddCommand(versionCmd)
+}
+
+// writerFromFlags builds a scaffold.Writer from the --force/--dry-run/--diff
+// persistent flags.
+func writerFromFlags() scaffold.Writer {
+	return scaffold.Writer{Force: flagForce, DryRun: flagDryRun, Diff: flagDiff}
+}
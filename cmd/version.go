@@ -0,0 +1,18 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// Version is set via -ldflags "-X github.com/cey0/NextGo/cmd.Version=..." at release time.
+var Version = "dev"
+
+var versionCmd = &cobra.Command{
+	Use:   "version",
+	Short: "Print the nextgo version",
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println("nextgo " + Version)
+	},
+}
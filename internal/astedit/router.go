@@ -0,0 +1,90 @@
+// Package astedit rewrites generated Go source files by parsing them and
+// using their AST to find where new code belongs, rather than
+// string-concatenating it onto the file, so `nextgo add` stays correct
+// regardless of how a router.go happens to be formatted.
+package astedit
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"strings"
+)
+
+// AddRouteToFunc inserts stmtSrc (a single Go statement, e.g.
+// `r.GET("/widgets", handlers.WidgetsHandler)`) into the named function in
+// path, just before its final statement (the `return` that serves the
+// router). It rewrites the file in place. If funcName already contains a
+// statement equivalent to stmtSrc, it is a no-op, so re-running `nextgo add`
+// with `--force` never duplicates a route registration.
+func AddRouteToFunc(path, funcName, stmtSrc string) error {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, src, 0)
+	if err != nil {
+		return fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	fn := findFunc(file, funcName)
+	if fn == nil {
+		return fmt.Errorf("func %s not found in %s", funcName, path)
+	}
+	if len(fn.Body.List) == 0 {
+		return fmt.Errorf("func %s in %s has an empty body", funcName, path)
+	}
+
+	if hasStmt(fset, fn, stmtSrc) {
+		return nil
+	}
+
+	// Insert the new statement as text just before the function's final
+	// statement, rather than splicing it into the AST: a stmt parsed against
+	// its own throwaway FileSet carries positions that collide with the real
+	// file's, which confuses the printer's line-break heuristics.
+	insertOffset := fset.Position(fn.Body.List[len(fn.Body.List)-1].Pos()).Offset
+
+	out := make([]byte, 0, len(src)+len(stmtSrc)+2)
+	out = append(out, src[:insertOffset]...)
+	out = append(out, []byte(stmtSrc+"\n\n")...)
+	out = append(out, src[insertOffset:]...)
+
+	clean, err := format.Source(out)
+	if err != nil {
+		return fmt.Errorf("formatting %s: %w", path, err)
+	}
+	return os.WriteFile(path, clean, 0644)
+}
+
+func findFunc(file *ast.File, name string) *ast.FuncDecl {
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Name.Name == name {
+			return fn
+		}
+	}
+	return nil
+}
+
+// hasStmt reports whether fn already contains a statement that formats to
+// the same source text as stmtSrc, ignoring surrounding whitespace.
+func hasStmt(fset *token.FileSet, fn *ast.FuncDecl, stmtSrc string) bool {
+	want := strings.TrimSpace(stmtSrc)
+	for _, stmt := range fn.Body.List {
+		var buf bytes.Buffer
+		if err := printer.Fprint(&buf, fset, stmt); err != nil {
+			continue
+		}
+		if strings.TrimSpace(buf.String()) == want {
+			return true
+		}
+	}
+	return false
+}
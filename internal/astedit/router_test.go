@@ -0,0 +1,55 @@
+package astedit
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAddRouteToFunc(t *testing.T) {
+	src := `package router
+
+import "net/http"
+
+func Start(addr string) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/health", handlers.Health)
+	return http.ListenAndServe(addr, mux)
+}
+`
+	dir := t.TempDir()
+	path := filepath.Join(dir, "router.go")
+	if err := os.WriteFile(path, []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := AddRouteToFunc(path, "Start", `mux.HandleFunc("/widgets", handlers.WidgetsHandler)`); err != nil {
+		t.Fatalf("AddRouteToFunc: %v", err)
+	}
+
+	out, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := string(out)
+	if !strings.Contains(got, `mux.HandleFunc("/widgets", handlers.WidgetsHandler)`) {
+		t.Fatalf("expected new route to be inserted, got:\n%s", got)
+	}
+	if strings.Index(got, "/widgets") > strings.Index(got, "return http.ListenAndServe") {
+		t.Fatalf("expected new route before the return statement, got:\n%s", got)
+	}
+}
+
+func TestAddRouteToFunc_MissingFunc(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "router.go")
+	if err := os.WriteFile(path, []byte("package router\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := AddRouteToFunc(path, "Start", `mux.HandleFunc("/widgets", handlers.WidgetsHandler)`); err == nil {
+		t.Fatal("expected an error for a missing Start func")
+	}
+}
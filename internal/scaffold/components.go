@@ -0,0 +1,148 @@
+package scaffold
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/cey0/NextGo/internal/astedit"
+)
+
+// componentContext is the data available to component templates
+// (pkg/handlers, pkg/models, pkg/middleware), distinct from TemplateContext
+// since components are rendered one at a time against an existing project.
+type componentContext struct {
+	Name   string
+	Route  string
+	Fields []Field
+}
+
+// AddHandler renders a new handler into pkg/handlers and registers it with
+// the project's router by inserting a route statement into Start in
+// pkg/router/router.go (see astedit.AddRouteToFunc).
+func AddHandler(projectRoot, name string, writer Writer) error {
+	preset, err := LookupPreset(manifestPreset(projectRoot))
+	if err != nil {
+		return err
+	}
+
+	route := strings.ToLower(name)
+	path := filepath.Join(projectRoot, "pkg", "handlers", strings.ToLower(name)+".go")
+	if _, err := renderComponent(path, "component/handler.txt", componentContext{Name: name, Route: route}, writer); err != nil {
+		return err
+	}
+
+	// Register the route regardless of whether the handler file itself
+	// changed: AddRouteToFunc is idempotent, so this also repairs a route
+	// that was hand-deleted from router.go without touching the handler.
+	stmt, err := renderRouteStatement(preset, route, name+"Handler")
+	if err != nil {
+		return err
+	}
+
+	if writer.DryRun {
+		fmt.Printf("Would register route: %s\n", stmt)
+		return nil
+	}
+
+	routerPath := filepath.Join(projectRoot, "pkg", "router", "router.go")
+	if err := astedit.AddRouteToFunc(routerPath, "Start", stmt); err != nil {
+		return fmt.Errorf("registering route in %s: %w", routerPath, err)
+	}
+	return nil
+}
+
+// AddModel renders a new struct into pkg/models from a name and a
+// `--fields name:type,...` spec.
+func AddModel(projectRoot, name, fieldSpec string, writer Writer) error {
+	fields, err := ParseFields(fieldSpec)
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(projectRoot, "pkg", "models", strings.ToLower(name)+".go")
+	_, err = renderComponent(path, "component/model.txt", componentContext{Name: name, Fields: fields}, writer)
+	return err
+}
+
+// AddMiddleware renders a new middleware into pkg/middleware.
+func AddMiddleware(projectRoot, name string, writer Writer) error {
+	path := filepath.Join(projectRoot, "pkg", "middleware", strings.ToLower(name)+".go")
+	_, err := renderComponent(path, "component/middleware.txt", componentContext{Name: name}, writer)
+	return err
+}
+
+// AddMigration renders a new, sequentially-numbered SQL migration into
+// pkg/db/migrations.
+func AddMigration(projectRoot, name string, writer Writer) error {
+	dir := filepath.Join(projectRoot, "pkg", "db", "migrations")
+	if !writer.DryRun {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("creating %s: %w", dir, err)
+		}
+	}
+
+	n, err := nextMigrationNumber(dir)
+	if err != nil {
+		return err
+	}
+
+	fileName := fmt.Sprintf("%04d_%s.sql", n, strings.ToLower(name))
+	path := filepath.Join(dir, fileName)
+	_, err = renderComponent(path, "component/migration.txt", componentContext{Name: name}, writer)
+	return err
+}
+
+func nextMigrationNumber(dir string) (int, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return 1, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("reading %s: %w", dir, err)
+	}
+
+	max := 0
+	for _, e := range entries {
+		prefix, _, ok := strings.Cut(e.Name(), "_")
+		if !ok {
+			continue
+		}
+		n, err := strconv.Atoi(prefix)
+		if err != nil {
+			continue
+		}
+		if n > max {
+			max = n
+		}
+	}
+	return max + 1, nil
+}
+
+func renderComponent(outPath, templateName string, ctx componentContext, writer Writer) (FileOpKind, error) {
+	resolver := NewResolver(OverlayDirFromEnv(""))
+	content, err := resolver.Render(templateName, ctx)
+	if err != nil {
+		return OpSkip, err
+	}
+	return writer.WriteFile(outPath, content)
+}
+
+func manifestPreset(projectRoot string) string {
+	m, err := ReadManifest(projectRoot)
+	if err != nil {
+		return DefaultPreset
+	}
+	return m.Preset
+}
+
+func renderRouteStatement(preset Preset, path, handler string) (string, error) {
+	resolver := NewResolver("")
+	return resolver.RenderString(preset.RouteStmtTemplate, struct {
+		Var     string
+		Path    string
+		Handler string
+	}{Var: preset.RouterVar, Path: path, Handler: handler})
+}
@@ -0,0 +1,51 @@
+// Package scaffold builds new NextGo projects and drives template rendering.
+package scaffold
+
+import "path/filepath"
+
+// Config describes the project a user wants generated. It is populated from
+// CLI flags (see cmd/new.go) rather than interactive prompts.
+type Config struct {
+	// ProjectName is the directory and Go module name for the new project.
+	ProjectName string
+	// Path is the directory the project will be created under.
+	Path string
+	// ModulePath is passed to `go mod init`. Defaults to ProjectName.
+	ModulePath string
+	// Preset selects which template bundle is written (see presets.go).
+	Preset string
+	// Port is the HTTP port the generated app listens on.
+	Port int
+	// WithDocker controls whether Dockerfile/docker-compose.yaml are written.
+	WithDocker bool
+	// WithAir controls whether .air.toml is written and air is required.
+	WithAir bool
+	// OverlayDir, if set, is checked for template overrides before the
+	// defaults embedded in the binary (see --template-dir / NEXTGO_TEMPLATES).
+	OverlayDir string
+	// Timezone, if set, is written into the container as TZ (e.g. "UTC").
+	Timezone string
+	// Volumes are extra host-relative paths to mount into the app container,
+	// in docker-compose's `./host:/container` form.
+	Volumes []string
+
+	// Force, DryRun and Diff control how conflicting files are handled; see Writer.
+	Force  bool
+	DryRun bool
+	Diff   bool
+
+	// NoGit skips git init and the initial commit.
+	NoGit bool
+	// GitRemote, if set, is added as the project's `origin` remote.
+	GitRemote string
+	// InstallHooks installs a pre-commit hook that runs gofmt/vet/build.
+	InstallHooks bool
+}
+
+// BasePath returns the full directory the project will be created in.
+func (c Config) BasePath() string {
+	if c.Path == "" {
+		return c.ProjectName
+	}
+	return filepath.Join(c.Path, c.ProjectName)
+}
@@ -0,0 +1,42 @@
+package scaffold
+
+// dbDockerSpec describes how a DB driver shows up in the generated
+// docker-compose.yaml: which image to run, what port it listens on, and how
+// to tell when it's ready to accept connections.
+type dbDockerSpec struct {
+	Image       string
+	Port        int
+	VolumePath  string
+	HealthCheck string
+	Env         []string
+}
+
+var dbDockerSpecs = map[string]dbDockerSpec{
+	"postgres": {
+		Image:       "postgres:16-alpine",
+		Port:        5432,
+		VolumePath:  "/var/lib/postgresql/data",
+		HealthCheck: "pg_isready -U postgres",
+		Env:         []string{"POSTGRES_USER=postgres", "POSTGRES_PASSWORD=postgres"},
+	},
+	"sqlite": {
+		// SQLite is an embedded file database; there is no server container.
+	},
+	"mongo": {
+		Image:       "mongo:7",
+		Port:        27017,
+		VolumePath:  "/data/db",
+		HealthCheck: "mongosh --quiet --eval 'db.runCommand(\"ping\").ok'",
+	},
+	"none": {},
+}
+
+// dbDockerSpecFor looks up the docker-compose wiring for a DB driver,
+// substituting the project name into the database name env var.
+func dbDockerSpecFor(driver, projectName string) dbDockerSpec {
+	spec := dbDockerSpecs[driver]
+	if driver == "postgres" {
+		spec.Env = append(append([]string{}, spec.Env...), "POSTGRES_DB="+projectName)
+	}
+	return spec
+}
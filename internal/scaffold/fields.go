@@ -0,0 +1,67 @@
+package scaffold
+
+import (
+	"fmt"
+	"go/token"
+	"strings"
+	"unicode"
+)
+
+// Field is one struct field of a generated model, parsed from a
+// `--fields name:type` flag value.
+type Field struct {
+	GoName   string
+	GoType   string
+	JSONName string
+}
+
+var fieldTypeAliases = map[string]string{
+	"int":    "int",
+	"string": "string",
+	"bool":   "bool",
+	"float":  "float64",
+}
+
+// ParseFields parses a comma-separated `name:type,name:type` spec, e.g.
+// "id:int,name:string", into Fields. Unknown types are passed through
+// verbatim so callers can use any valid Go type (e.g. "time.Time").
+func ParseFields(spec string) ([]Field, error) {
+	if spec == "" {
+		return nil, nil
+	}
+
+	var fields []Field
+	for _, part := range strings.Split(spec, ",") {
+		name, typ, ok := strings.Cut(part, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid field %q, expected name:type", part)
+		}
+		name = strings.TrimSpace(name)
+		typ = strings.TrimSpace(typ)
+		if name == "" || typ == "" {
+			return nil, fmt.Errorf("invalid field %q, expected name:type", part)
+		}
+		if !token.IsIdentifier(name) {
+			return nil, fmt.Errorf("invalid field name %q: must be a legal Go identifier", name)
+		}
+
+		if alias, ok := fieldTypeAliases[typ]; ok {
+			typ = alias
+		}
+
+		fields = append(fields, Field{
+			GoName:   exportedName(name),
+			GoType:   typ,
+			JSONName: name,
+		})
+	}
+	return fields, nil
+}
+
+// exportedName upper-cases the first rune of name so it becomes an exported
+// Go identifier, e.g. "id" -> "Id", "user_id" -> "User_id".
+func exportedName(name string) string {
+	r := []rune(name)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
@@ -0,0 +1,124 @@
+package scaffold
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// FileOpKind describes what a Writer decided to do about a single file.
+type FileOpKind int
+
+const (
+	// OpCreate means the file did not exist and was written.
+	OpCreate FileOpKind = iota
+	// OpSkip means the file already existed with different content and was
+	// left alone (no --force), or already matched and nothing changed.
+	OpSkip
+	// OpOverwrite means the file existed with different content and was
+	// replaced because --force was set.
+	OpOverwrite
+	// OpMerge is reserved for template files that know how to combine
+	// generated and hand-edited content; no current template needs it.
+	OpMerge
+)
+
+func (k FileOpKind) String() string {
+	switch k {
+	case OpCreate:
+		return "create"
+	case OpSkip:
+		return "skip"
+	case OpOverwrite:
+		return "overwrite"
+	case OpMerge:
+		return "merge"
+	default:
+		return "unknown"
+	}
+}
+
+// Writer decides, per file, whether to create/skip/overwrite based on
+// existing content, and applies that decision unless DryRun is set. This is
+// what makes `nextgo new` and `nextgo add` safe to re-run: unchanged files
+// are left alone, changed files are reported instead of silently clobbered.
+type Writer struct {
+	// Force overwrites existing files whose content differs from what would
+	// be generated. Without it, conflicting files are skipped.
+	Force bool
+	// DryRun reports what would happen without touching the filesystem.
+	DryRun bool
+	// Diff prints a unified diff for any file that would be overwritten or
+	// is being skipped due to a conflict.
+	Diff bool
+}
+
+// WriteFile decides the FileOpKind for path given its desired content, then
+// performs (or, in dry-run mode, merely reports) that action.
+func (w Writer) WriteFile(path string, content []byte) (FileOpKind, error) {
+	existing, err := os.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+		return w.apply(path, content, OpCreate)
+	case err != nil:
+		return OpSkip, fmt.Errorf("reading %s: %w", path, err)
+	case hash(existing) == hash(content):
+		return OpSkip, nil
+	case w.Force:
+		if w.Diff {
+			printDiff(path, existing, content)
+		}
+		return w.apply(path, content, OpOverwrite)
+	default:
+		if w.Diff {
+			printDiff(path, existing, content)
+		}
+		fmt.Printf("Skipped (would overwrite, use --force): %s\n", path)
+		return OpSkip, nil
+	}
+}
+
+func (w Writer) apply(path string, content []byte, op FileOpKind) (FileOpKind, error) {
+	if w.DryRun {
+		fmt.Printf("Would %s: %s\n", op, path)
+		return op, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return op, fmt.Errorf("creating %s: %w", filepath.Dir(path), err)
+	}
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		return op, fmt.Errorf("writing %s: %w", path, err)
+	}
+	fmt.Printf("%s: %s\n", capitalize(op.String()), path)
+	return op, nil
+}
+
+func printDiff(path string, a, b []byte) {
+	diff := difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(a)),
+		B:        difflib.SplitLines(string(b)),
+		FromFile: path + " (current)",
+		ToFile:   path + " (generated)",
+		Context:  3,
+	}
+	text, err := difflib.GetUnifiedDiffString(diff)
+	if err != nil {
+		return
+	}
+	fmt.Print(text)
+}
+
+func hash(b []byte) [32]byte {
+	return sha256.Sum256(b)
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return string(s[0]-'a'+'A') + s[1:]
+}
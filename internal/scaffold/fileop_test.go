@@ -0,0 +1,123 @@
+package scaffold
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteFile_Create(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "file.txt")
+
+	op, err := Writer{}.WriteFile(path, []byte("hello"))
+	if err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	if op != OpCreate {
+		t.Fatalf("op = %s, want create", op)
+	}
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "hello" {
+		t.Fatalf("content = %q, want %q", got, "hello")
+	}
+}
+
+func TestWriteFile_SkipWhenUnchanged(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file.txt")
+	if err := os.WriteFile(path, []byte("hello"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	op, err := Writer{}.WriteFile(path, []byte("hello"))
+	if err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	if op != OpSkip {
+		t.Fatalf("op = %s, want skip", op)
+	}
+}
+
+func TestWriteFile_SkipOnConflictWithoutForce(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file.txt")
+	if err := os.WriteFile(path, []byte("hand-edited"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	op, err := Writer{}.WriteFile(path, []byte("generated"))
+	if err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	if op != OpSkip {
+		t.Fatalf("op = %s, want skip", op)
+	}
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "hand-edited" {
+		t.Fatalf("existing file was overwritten without --force: %q", got)
+	}
+}
+
+func TestWriteFile_OverwriteWithForce(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file.txt")
+	if err := os.WriteFile(path, []byte("hand-edited"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	op, err := Writer{Force: true}.WriteFile(path, []byte("generated"))
+	if err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	if op != OpOverwrite {
+		t.Fatalf("op = %s, want overwrite", op)
+	}
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "generated" {
+		t.Fatalf("content = %q, want %q", got, "generated")
+	}
+}
+
+func TestWriteFile_DryRunDoesNotTouchDisk(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "file.txt")
+
+	op, err := Writer{DryRun: true}.WriteFile(path, []byte("generated"))
+	if err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	if op != OpCreate {
+		t.Fatalf("op = %s, want create", op)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("expected dry-run to leave no file on disk, stat err = %v", err)
+	}
+
+	if err := os.WriteFile(path, []byte("hand-edited"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	op, err = Writer{Force: true, DryRun: true}.WriteFile(path, []byte("generated"))
+	if err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	if op != OpOverwrite {
+		t.Fatalf("op = %s, want overwrite", op)
+	}
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "hand-edited" {
+		t.Fatalf("dry-run overwrite touched disk: %q", got)
+	}
+}
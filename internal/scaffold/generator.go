@@ -0,0 +1,219 @@
+package scaffold
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// CheckCommandExists reports whether cmd is available in the user's PATH.
+func CheckCommandExists(cmd string) bool {
+	_, err := exec.LookPath(cmd)
+	return err == nil
+}
+
+// EnsureAirInstalled fatals with install instructions if air is required but missing.
+func EnsureAirInstalled() {
+	if !CheckCommandExists("air") {
+		log.Fatalf("Error: 'air' is not installed. Please install it by running:\n\n\tcurl -sSfL https://raw.githubusercontent.com/air-verse/air/master/install.sh | sh -s -- -b $(go env GOPATH)/bin\n")
+	}
+}
+
+// Generate creates the project directory tree described by cfg, rendering
+// the preset's router/db templates alongside the common scaffolding. It is
+// safe to call against a directory that already exists: existing files are
+// left alone unless cfg.Force is set (see Writer).
+func Generate(cfg Config) error {
+	preset, err := LookupPreset(cfg.Preset)
+	if err != nil {
+		return err
+	}
+
+	if cfg.WithAir {
+		EnsureAirInstalled()
+	}
+
+	basePath := cfg.BasePath()
+	writer := Writer{Force: cfg.Force, DryRun: cfg.DryRun, Diff: cfg.Diff}
+
+	if err := ensureDir(basePath, writer); err != nil {
+		return fmt.Errorf("failed to create project base directory: %w", err)
+	}
+
+	resolver := NewResolver(cfg.OverlayDir)
+	dbSpec := dbDockerSpecFor(preset.DBDriver, cfg.ProjectName)
+	ctx := TemplateContext{
+		ProjectName:   cfg.ProjectName,
+		ModulePath:    cfg.ModulePath,
+		Port:          cfg.Port,
+		GoVersion:     detectGoVersion(),
+		DBDriver:      preset.DBDriver,
+		WithDocker:    cfg.WithDocker,
+		WithAir:       cfg.WithAir,
+		Timezone:      cfg.Timezone,
+		Volumes:       cfg.Volumes,
+		DBImage:       dbSpec.Image,
+		DBPort:        dbSpec.Port,
+		DBVolumePath:  dbSpec.VolumePath,
+		DBHealthCheck: dbSpec.HealthCheck,
+		DBEnv:         dbSpec.Env,
+	}
+
+	if err := createDirectoriesAndFiles(basePath, resolver, ctx, preset, cfg, writer); err != nil {
+		return err
+	}
+
+	manifest := Manifest{ModulePath: cfg.ModulePath, Preset: cfg.Preset, Port: cfg.Port}
+	manifestContent, err := manifest.Render()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.WriteFile(filepath.Join(basePath, ManifestFileName), manifestContent); err != nil {
+		return fmt.Errorf("writing %s: %w", ManifestFileName, err)
+	}
+
+	if cfg.DryRun {
+		fmt.Println("Dry run: skipping `go mod init`/`go mod tidy`")
+	} else if err := initializeGoMod(basePath, cfg.ModulePath); err != nil {
+		return err
+	}
+
+	gitOpts := GitOptions{Enabled: !cfg.NoGit, Remote: cfg.GitRemote, InstallHooks: cfg.InstallHooks}
+	return SetupGit(basePath, gitOpts, resolver, ctx, writer)
+}
+
+// ensureDir creates dir if it doesn't already exist, respecting DryRun.
+func ensureDir(dir string, writer Writer) error {
+	if fileExists(dir) {
+		return nil
+	}
+	if writer.DryRun {
+		fmt.Printf("Would create directory: %s\n", dir)
+		return nil
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	fmt.Printf("Created directory: %s\n", dir)
+	return nil
+}
+
+// createDirectoriesAndFiles creates the necessary project directories and initial files.
+func createDirectoriesAndFiles(basePath string, resolver *Resolver, ctx TemplateContext, preset Preset, cfg Config, writer Writer) error {
+	dirs := map[string][]string{
+		"cmd":            {"main.go"},
+		"pkg/router":     {"router.go"},
+		"pkg/middleware": {"middleware.go"},
+		"pkg/handlers":   {"handlers.go"},
+		"pkg/models":     {"models.go"},
+		"pkg/db":         {"db.go"},
+		"config":         {"config.yaml"},
+	}
+
+	for dir, files := range dirs {
+		dirPath := filepath.Join(basePath, dir)
+		if err := ensureDir(dirPath, writer); err != nil {
+			return fmt.Errorf("creating directory %s: %w", dirPath, err)
+		}
+
+		for _, file := range files {
+			if err := writeProjectFile(dirPath, file, resolver, ctx, preset, writer); err != nil {
+				return err
+			}
+		}
+	}
+
+	extras := []string{"Makefile"}
+	if cfg.WithAir {
+		extras = append(extras, ".air.toml")
+	}
+	if cfg.WithDocker {
+		extras = append(extras, "Dockerfile", "docker-compose.yaml", ".env")
+	}
+	for _, file := range extras {
+		if err := writeProjectFile(basePath, file, resolver, ctx, preset, writer); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeProjectFile(dirPath, file string, resolver *Resolver, ctx TemplateContext, preset Preset, writer Writer) error {
+	filePath := filepath.Join(dirPath, file)
+
+	content, err := resolver.Render(templateName(file, preset), ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = writer.WriteFile(filePath, content)
+	return err
+}
+
+// templateName returns the template name for a generated file, relative to
+// the templates root. router.go and db.go resolve against the selected
+// preset's bundle; the rest are shared across every preset.
+func templateName(fileName string, preset Preset) string {
+	switch fileName {
+	case "router.go":
+		return preset.Router
+	case "db.go":
+		return preset.DB
+	}
+
+	templateMapping := map[string]string{
+		"main.go":             "main.txt",
+		"middleware.go":       "middleware.txt",
+		"handlers.go":         "handlers.txt",
+		"models.go":           "models.txt",
+		"Dockerfile":          "dockers.txt",
+		"docker-compose.yaml": "docker.txt",
+		".env":                "env.txt",
+		"Makefile":            "makerun.txt",
+		".air.toml":           "air.txt",
+		"config.yaml":         "config.txt",
+	}
+	return templateMapping[fileName]
+}
+
+// detectGoVersion returns the Go toolchain version the generator itself is
+// running with, e.g. "1.21.5", for use in generated Dockerfiles.
+func detectGoVersion() string {
+	return strings.TrimPrefix(runtime.Version(), "go")
+}
+
+// runCommand runs a shell command in the given directory.
+func runCommand(dir, command string, args ...string) error {
+	cmd := exec.Command(command, args...)
+	cmd.Dir = dir
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// initializeGoMod initializes a Go module in the project directory, unless
+// one already exists (re-running `nextgo new` shouldn't re-init it).
+func initializeGoMod(basePath, modulePath string) error {
+	if fileExists(filepath.Join(basePath, "go.mod")) {
+		fmt.Println("go.mod already exists, skipping `go mod init`")
+		return nil
+	}
+
+	fmt.Println("Initializing Go module...")
+	if err := runCommand(basePath, "go", "mod", "init", modulePath); err != nil {
+		return fmt.Errorf("initializing Go module: %w", err)
+	}
+
+	fmt.Println("Tidying up Go module dependencies...")
+	if err := runCommand(basePath, "go", "mod", "tidy"); err != nil {
+		return fmt.Errorf("tidying Go module: %w", err)
+	}
+
+	fmt.Println("Go module initialized successfully!")
+	return nil
+}
@@ -0,0 +1,100 @@
+package scaffold
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// GitOptions controls the post-generation git setup performed by SetupGit.
+type GitOptions struct {
+	// Enabled runs `git init` and the initial commit. Disabled by --no-git.
+	Enabled bool
+	// Remote, if set, is added as the `origin` remote.
+	Remote string
+	// InstallHooks installs a pre-commit hook that runs gofmt/vet/build.
+	InstallHooks bool
+}
+
+// SetupGit initializes a git repository in basePath, writes a .gitignore,
+// optionally installs a pre-commit hook, and creates the initial commit.
+func SetupGit(basePath string, opts GitOptions, resolver *Resolver, ctx TemplateContext, writer Writer) error {
+	if !opts.Enabled {
+		return nil
+	}
+
+	if fileExists(filepath.Join(basePath, ".git")) {
+		fmt.Println(".git already exists, skipping git init")
+		return nil
+	}
+
+	if writer.DryRun {
+		fmt.Println("Would run: git init")
+		if opts.Remote != "" {
+			fmt.Printf("Would run: git remote add origin %s\n", opts.Remote)
+		}
+		if opts.InstallHooks {
+			fmt.Println("Would install pre-commit hook")
+		}
+		fmt.Println("Would run: git add -A && git commit -m \"chore: scaffold with nextgo\"")
+		return nil
+	}
+
+	if err := runCommand(basePath, "git", "init"); err != nil {
+		return fmt.Errorf("git init: %w", err)
+	}
+
+	gitignore, err := resolver.Render("gitignore.txt", ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := writer.WriteFile(filepath.Join(basePath, ".gitignore"), gitignore); err != nil {
+		return err
+	}
+
+	if opts.Remote != "" {
+		if err := runCommand(basePath, "git", "remote", "add", "origin", opts.Remote); err != nil {
+			return fmt.Errorf("git remote add origin: %w", err)
+		}
+	}
+
+	if opts.InstallHooks {
+		if err := installPreCommitHook(basePath, resolver, ctx); err != nil {
+			return err
+		}
+	}
+
+	if err := runCommand(basePath, "git", "add", "-A"); err != nil {
+		return fmt.Errorf("git add: %w", err)
+	}
+	if err := runCommand(basePath, "git", "commit", "-m", "chore: scaffold with nextgo"); err != nil {
+		return fmt.Errorf("git commit: %w", err)
+	}
+
+	return nil
+}
+
+// installPreCommitHook writes .git/hooks/pre-commit, moving any existing
+// hooks directory to hooks.old first so the change is reversible.
+func installPreCommitHook(basePath string, resolver *Resolver, ctx TemplateContext) error {
+	hooksDir := filepath.Join(basePath, ".git", "hooks")
+	if fileExists(hooksDir) {
+		if err := os.Rename(hooksDir, hooksDir+".old"); err != nil {
+			return fmt.Errorf("backing up %s: %w", hooksDir, err)
+		}
+	}
+	if err := os.MkdirAll(hooksDir, 0755); err != nil {
+		return fmt.Errorf("creating %s: %w", hooksDir, err)
+	}
+
+	content, err := resolver.Render("precommit.txt", ctx)
+	if err != nil {
+		return err
+	}
+
+	hookPath := filepath.Join(hooksDir, "pre-commit")
+	if err := os.WriteFile(hookPath, content, 0755); err != nil {
+		return fmt.Errorf("writing %s: %w", hookPath, err)
+	}
+	return nil
+}
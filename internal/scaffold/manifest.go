@@ -0,0 +1,86 @@
+package scaffold
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ManifestFileName is written at the root of every generated project so that
+// `nextgo add` can later find the project and recover how it was scaffolded.
+const ManifestFileName = ".nextgo.yaml"
+
+// Manifest records the choices a project was scaffolded with. It's
+// deliberately flat and hand-rolled rather than pulled in through a YAML
+// library, since config.yaml (see templates/config.txt) follows the same
+// convention.
+type Manifest struct {
+	ModulePath string
+	Preset     string
+	Port       int
+}
+
+// Render produces the manifest's file content.
+func (m Manifest) Render() ([]byte, error) {
+	return []byte(fmt.Sprintf("module_path: %s\npreset: %s\nport: %d\n", m.ModulePath, m.Preset, m.Port)), nil
+}
+
+// ReadManifest loads the manifest from <basePath>/.nextgo.yaml.
+func ReadManifest(basePath string) (Manifest, error) {
+	data, err := os.ReadFile(filepath.Join(basePath, ManifestFileName))
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	var m Manifest
+	for _, line := range strings.Split(string(data), "\n") {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+		switch key {
+		case "module_path":
+			m.ModulePath = value
+		case "preset":
+			m.Preset = value
+		case "port":
+			port, err := strconv.Atoi(value)
+			if err != nil {
+				return Manifest{}, fmt.Errorf("parsing port in %s: %w", ManifestFileName, err)
+			}
+			m.Port = port
+		}
+	}
+	return m, nil
+}
+
+// FindProjectRoot walks upward from startDir looking for a directory that
+// contains both go.mod and .nextgo.yaml, i.e. the root of a nextgo-scaffolded
+// project.
+func FindProjectRoot(startDir string) (string, error) {
+	dir, err := filepath.Abs(startDir)
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		if fileExists(filepath.Join(dir, "go.mod")) && fileExists(filepath.Join(dir, ManifestFileName)) {
+			return dir, nil
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("no nextgo project found (missing go.mod + %s) above %s", ManifestFileName, startDir)
+		}
+		dir = parent
+	}
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
@@ -0,0 +1,84 @@
+package scaffold
+
+import "fmt"
+
+// Preset is a named bundle of templates written into pkg/router and pkg/db;
+// pkg/handlers is rendered from a template shared by every preset. Adding a
+// new stack means adding a Preset, not branching the generator itself.
+type Preset struct {
+	Name        string
+	Description string
+	// Router is the template rendered into pkg/router/router.go.
+	Router string
+	// DB is the template rendered into pkg/db/db.go.
+	DB string
+	// DBDriver is the import path / driver name exposed to templates as .DBDriver.
+	DBDriver string
+	// RouterVar is the variable name the generated router.go binds its
+	// router/mux to, e.g. "r" for gin and chi, "mux" for net/http.
+	RouterVar string
+	// RouteStmtTemplate is a text/template statement inserted into Start(),
+	// just before its final return, by `nextgo add handler`, rendered with
+	// {Var, Path, Handler}.
+	RouteStmtTemplate string
+}
+
+// Presets is the set of stacks `nextgo new --preset <name>` understands.
+var Presets = map[string]Preset{
+	"gin-postgres": {
+		Name:              "gin-postgres",
+		Description:       "Gin router with a Postgres database via database/sql + pgx",
+		Router:            "gin-postgres/routes.txt",
+		DB:                "gin-postgres/db.txt",
+		DBDriver:          "postgres",
+		RouterVar:         "r",
+		RouteStmtTemplate: `{{.Var}}.GET("/{{.Path}}", gin.WrapF(handlers.{{.Handler}}))`,
+	},
+	"chi-sqlite": {
+		Name:              "chi-sqlite",
+		Description:       "chi router with an embedded SQLite database",
+		Router:            "chi-sqlite/routes.txt",
+		DB:                "chi-sqlite/db.txt",
+		DBDriver:          "sqlite",
+		RouterVar:         "r",
+		RouteStmtTemplate: `{{.Var}}.Get("/{{.Path}}", handlers.{{.Handler}})`,
+	},
+	"echo-mongo": {
+		Name:              "echo-mongo",
+		Description:       "Echo router with a MongoDB database",
+		Router:            "echo-mongo/routes.txt",
+		DB:                "echo-mongo/db.txt",
+		DBDriver:          "mongo",
+		RouterVar:         "e",
+		RouteStmtTemplate: `{{.Var}}.GET("/{{.Path}}", echo.WrapHandler(http.HandlerFunc(handlers.{{.Handler}})))`,
+	},
+	"net-http-minimal": {
+		Name:              "net-http-minimal",
+		Description:       "Plain net/http router with no database wiring",
+		Router:            "net-http-minimal/routes.txt",
+		DB:                "net-http-minimal/db.txt",
+		DBDriver:          "none",
+		RouterVar:         "mux",
+		RouteStmtTemplate: `{{.Var}}.HandleFunc("/{{.Path}}", handlers.{{.Handler}})`,
+	},
+}
+
+// DefaultPreset is used when `new` is invoked without --preset.
+const DefaultPreset = "net-http-minimal"
+
+// LookupPreset validates a preset name and returns it.
+func LookupPreset(name string) (Preset, error) {
+	p, ok := Presets[name]
+	if !ok {
+		return Preset{}, fmt.Errorf("unknown preset %q (available: %s)", name, presetNames())
+	}
+	return p, nil
+}
+
+func presetNames() string {
+	names := make([]string, 0, len(Presets))
+	for n := range Presets {
+		names = append(names, n)
+	}
+	return fmt.Sprint(names)
+}
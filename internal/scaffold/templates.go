@@ -0,0 +1,119 @@
+package scaffold
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"text/template"
+)
+
+//go:embed templates
+var embeddedTemplates embed.FS
+
+const embedRoot = "templates"
+
+// TemplateContext is the data made available to every project template.
+type TemplateContext struct {
+	ProjectName string
+	ModulePath  string
+	Port        int
+	GoVersion   string
+	DBDriver    string
+	WithDocker  bool
+	WithAir     bool
+
+	// Timezone, if set, is written into the container as TZ.
+	Timezone string
+	// Volumes are extra host-relative paths mounted into the app container.
+	Volumes []string
+
+	// DBImage, DBPort, DBVolumePath, DBHealthCheck and DBEnv describe the
+	// docker-compose service for DBDriver; zero values for drivers (like
+	// sqlite) that don't run as a separate container.
+	DBImage       string
+	DBPort        int
+	DBVolumePath  string
+	DBHealthCheck string
+	DBEnv         []string
+}
+
+// Resolver renders named templates, preferring an overlay directory over the
+// templates embedded into the binary.
+type Resolver struct {
+	// OverlayDir, if set, is checked for each template name before the embed.
+	OverlayDir string
+}
+
+// NewResolver builds a Resolver. overlayDir may be empty, in which case only
+// the embedded defaults are used.
+func NewResolver(overlayDir string) *Resolver {
+	return &Resolver{OverlayDir: overlayDir}
+}
+
+// Render resolves name (e.g. "gin-postgres/routes.txt") against the overlay
+// directory first, falling back to the embedded default, then executes it as
+// a text/template against ctx (a TemplateContext for project templates, or a
+// component-specific context for `nextgo add` templates).
+func (r *Resolver) Render(name string, ctx any) ([]byte, error) {
+	raw, err := r.read(name)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err := template.New(name).Parse(string(raw))
+	if err != nil {
+		return nil, fmt.Errorf("parsing template %s: %w", name, err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, ctx); err != nil {
+		return nil, fmt.Errorf("executing template %s: %w", name, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// RenderString executes an inline template string (e.g. a Preset's
+// RouteStmtTemplate) against ctx, without going through the overlay/embed
+// resolution Render uses for named template files.
+func (r *Resolver) RenderString(src string, ctx any) (string, error) {
+	tmpl, err := template.New("inline").Parse(src)
+	if err != nil {
+		return "", fmt.Errorf("parsing template %q: %w", src, err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, ctx); err != nil {
+		return "", fmt.Errorf("executing template %q: %w", src, err)
+	}
+	return buf.String(), nil
+}
+
+func (r *Resolver) read(name string) ([]byte, error) {
+	if r.OverlayDir != "" {
+		content, err := os.ReadFile(filepath.Join(r.OverlayDir, name))
+		if err == nil {
+			return content, nil
+		}
+		if !os.IsNotExist(err) {
+			return nil, fmt.Errorf("reading overlay template %s: %w", name, err)
+		}
+	}
+
+	content, err := fs.ReadFile(embeddedTemplates, filepath.ToSlash(filepath.Join(embedRoot, name)))
+	if err != nil {
+		return nil, fmt.Errorf("reading embedded template %s: %w", name, err)
+	}
+	return content, nil
+}
+
+// OverlayDirFromEnv resolves the template overlay directory from the
+// --template-dir flag, falling back to the NEXTGO_TEMPLATES env var.
+func OverlayDirFromEnv(flagValue string) string {
+	if flagValue != "" {
+		return flagValue
+	}
+	return os.Getenv("NEXTGO_TEMPLATES")
+}
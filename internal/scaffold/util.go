@@ -0,0 +1,19 @@
+package scaffold
+
+import (
+	"os/user"
+	"path/filepath"
+	"strings"
+)
+
+// ExpandTilde expands a leading ~/ in path to the current user's home directory.
+func ExpandTilde(path string) (string, error) {
+	if !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+	usr, err := user.Current()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(usr.HomeDir, path[2:]), nil
+}